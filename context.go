@@ -0,0 +1,78 @@
+package runner
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// RunContext runs from a specified zero based starting index, the same way
+// Run does, but derives a per-step context.Context for each step. The
+// per-step context is cancelled when ctx is cancelled or, if the step sets
+// Timeout, when that timeout elapses. A step that defines RunCtx is run
+// through it with the derived context; steps without RunCtx fall back to
+// Run, which is not itself cancellable.
+//
+// If a step's context is done before or while it runs, RunContext stops the
+// pipeline and returns the context's error, wrapped with the step name and
+// index in the same way a regular step error is.
+func (ss Steps) RunContext(ctx context.Context, startIndex int) error {
+	localLogger := log.Logger.With().Int("lastStep", len(ss)-1).Logger()
+
+	if startStep := ss[startIndex]; startStep.Dependent {
+		return errors.Errorf("step %v: %q cannot be started independently, it relies on previous steps", startIndex, startStep.Name)
+	}
+
+	for i, step := range ss {
+		if i < startIndex || step.shouldSkip() {
+			localLogger.Info().Int("step", i).Str("name", step.Name).Msg("skip step")
+			continue
+		}
+
+		localLogger.Info().Int("step", i).Str("name", step.Name).Msg("do step")
+
+		if err := runStepContext(ctx, step); err != nil {
+			return errors.Wrapf(err, "could not perform step %v, %v", i, step.Name)
+		}
+	}
+	return nil
+}
+
+func runStepContext(ctx context.Context, step Step) error {
+	stepCtx := ctx
+	cancel := func() {}
+	if step.Timeout > 0 {
+		stepCtx, cancel = context.WithTimeout(ctx, step.Timeout)
+	}
+	defer cancel()
+
+	return runOnce(stepCtx, step)
+}
+
+// runOnce runs step's RunCtx if it defines one, cancelling it when ctx is
+// done, or falls back to Run, checked against ctx first since Run itself
+// cannot be interrupted.
+func runOnce(ctx context.Context, step Step) error {
+	if step.RunCtx == nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if step.Run != nil {
+			return step.Run()
+		}
+		return nil
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- step.RunCtx(ctx)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-done:
+		return err
+	}
+}
@@ -0,0 +1,72 @@
+package runner
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSteps_RunContext(t *testing.T) {
+	t.Run("standard flow, runs all steps via Run fallback", func(t *testing.T) {
+		f1, f2, f3 := new(mock), new(mock), new(mock)
+		ss := Steps([]Step{{Run: f1.success}, {Run: f2.success}, {Run: f3.success}})
+
+		err := ss.RunContext(context.Background(), 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, f1.called)
+		assert.Equal(t, 1, f2.called)
+		assert.Equal(t, 1, f3.called)
+	})
+
+	t.Run("runs RunCtx in preference to Run", func(t *testing.T) {
+		called := false
+		ss := Steps([]Step{{Name: "a", RunCtx: func(ctx context.Context) error {
+			called = true
+			return nil
+		}}})
+
+		err := ss.RunContext(context.Background(), 0)
+
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("cancelled parent context stops the pipeline", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ss := Steps([]Step{{Name: "a", RunCtx: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}}})
+
+		err := ss.RunContext(ctx, 0)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("step timeout cancels the step and stops the pipeline", func(t *testing.T) {
+		ss := Steps([]Step{{Name: "a", Timeout: time.Millisecond, RunCtx: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}}})
+
+		err := ss.RunContext(context.Background(), 0)
+
+		assert.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "step 0"), "wrong error type")
+	})
+
+	t.Run("starting on a Dependent step -> error", func(t *testing.T) {
+		f1 := new(mock)
+		ss := Steps([]Step{{Run: f1.success, Dependent: true}})
+
+		err := ss.RunContext(context.Background(), 0)
+
+		assert.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "cannot be started independently"), "wrong error type")
+	})
+}
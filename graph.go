@@ -0,0 +1,130 @@
+package runner
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Graph is a set of Steps run as a dependency graph instead of a flat
+// sequence. Each Step's DependsOn names the steps, by Name, that must
+// complete before it may start. Steps with no dependency relationship
+// between them run concurrently.
+type Graph struct {
+	Steps Steps
+}
+
+// NewGraph builds a Graph from ss.
+func NewGraph(ss Steps) Graph {
+	return Graph{Steps: ss}
+}
+
+// Run performs a topological run of the graph: it repeatedly starts every
+// step whose dependencies have all completed, running each such batch
+// concurrently, until no step remains. When a step fails, every step that
+// depends on it, directly or transitively, is reported in the returned
+// results as skipped, with Err describing the upstream step that caused
+// it. A step skipped by its SkipFunc, or because ctx was already done,
+// does not cascade: its dependents still run. A step whose OnError is Continue or Skip does not
+// cascade: its failure is recorded in its own Result, but its dependents
+// run normally, the same way Steps.Run tolerates it.
+//
+// Run returns an error if a DependsOn name does not match any step, or if
+// the graph contains a dependency cycle. A cycle is detected by Kahn's
+// algorithm: any step whose indegree never reaches zero is never dequeued,
+// and every such step is named in the returned error.
+func (g Graph) Run(ctx context.Context) ([]Result, error) {
+	n := len(g.Steps)
+	indexByName := make(map[string]int, n)
+	for i, step := range g.Steps {
+		indexByName[step.Name] = i
+	}
+
+	indegree := make([]int, n)
+	dependents := make([][]int, n)
+	for i, step := range g.Steps {
+		for _, dep := range step.DependsOn {
+			j, ok := indexByName[dep]
+			if !ok {
+				return nil, errors.Errorf("step %v: %q depends on unknown step %q", i, step.Name, dep)
+			}
+			dependents[j] = append(dependents[j], i)
+			indegree[i]++
+		}
+	}
+
+	results := make([]Result, n)
+	skipReason := make([]error, n)
+	dequeued := make([]bool, n)
+
+	var queue []int
+	for i, d := range indegree {
+		if d == 0 {
+			queue = append(queue, i)
+		}
+	}
+
+	for len(queue) > 0 {
+		batch := queue
+		queue = nil
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+
+		for _, i := range batch {
+			i := i
+			dequeued[i] = true
+
+			if reason := skipReason[i]; reason != nil {
+				results[i] = Result{Index: i, Name: getName(g.Steps[i], i), Skipped: true, Err: reason}
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res := runStep(ctx, g.Steps[i], i)
+				mu.Lock()
+				results[i] = res
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		for _, i := range batch {
+			step := g.Steps[i]
+
+			var reason error
+			switch {
+			case results[i].Failed && step.OnError == Abort:
+				reason = errors.Errorf("upstream step %v: %q failed", i, step.Name)
+			case results[i].Skipped && results[i].Err != nil:
+				reason = results[i].Err
+			}
+
+			for _, d := range dependents[i] {
+				indegree[d]--
+				if reason != nil && skipReason[d] == nil {
+					skipReason[d] = reason
+				}
+				if indegree[d] == 0 {
+					queue = append(queue, d)
+				}
+			}
+		}
+	}
+
+	var cycle []string
+	for i, ok := range dequeued {
+		if !ok {
+			cycle = append(cycle, g.Steps[i].Name)
+		}
+	}
+	if len(cycle) > 0 {
+		return results, errors.Errorf("dependency cycle detected among steps: %v", strings.Join(cycle, ", "))
+	}
+
+	return results, nil
+}
@@ -0,0 +1,107 @@
+package runner
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_Run(t *testing.T) {
+	t.Run("runs independent steps and respects dependencies", func(t *testing.T) {
+		fBuild, fTest, fLint, fDeploy := new(mock), new(mock), new(mock), new(mock)
+		g := NewGraph(Steps{
+			{Name: "build", Run: fBuild.success},
+			{Name: "test", Run: fTest.success, DependsOn: []string{"build"}},
+			{Name: "lint", Run: fLint.success, DependsOn: []string{"build"}},
+			{Name: "deploy", Run: fDeploy.success, DependsOn: []string{"test", "lint"}},
+		})
+
+		results, err := g.Run(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 4)
+		for _, r := range results {
+			assert.False(t, r.Failed)
+			assert.False(t, r.Skipped)
+		}
+		assert.Equal(t, 1, fBuild.called)
+		assert.Equal(t, 1, fTest.called)
+		assert.Equal(t, 1, fLint.called)
+		assert.Equal(t, 1, fDeploy.called)
+	})
+
+	t.Run("a failing step skips everything downstream", func(t *testing.T) {
+		fBuild, fTest, fDeploy := new(mock), new(mock), new(mock)
+		g := NewGraph(Steps{
+			{Name: "build", Run: fBuild.throw},
+			{Name: "test", Run: fTest.success, DependsOn: []string{"build"}},
+			{Name: "deploy", Run: fDeploy.success, DependsOn: []string{"test"}},
+		})
+
+		results, err := g.Run(context.Background())
+
+		assert.NoError(t, err)
+		assert.True(t, results[0].Failed)
+		assert.True(t, results[1].Skipped)
+		assert.Error(t, results[1].Err)
+		assert.True(t, results[2].Skipped)
+		assert.Error(t, results[2].Err)
+		assert.Equal(t, 0, fTest.called)
+		assert.Equal(t, 0, fDeploy.called)
+	})
+
+	t.Run("OnError Continue on a failing step does not cascade to dependents", func(t *testing.T) {
+		fBuild, fTest := new(mock), new(mock)
+		g := NewGraph(Steps{
+			{Name: "build", Run: fBuild.throw, OnError: Continue},
+			{Name: "test", Run: fTest.success, DependsOn: []string{"build"}},
+		})
+
+		results, err := g.Run(context.Background())
+
+		assert.NoError(t, err)
+		assert.True(t, results[0].Failed)
+		assert.False(t, results[1].Skipped)
+		assert.Equal(t, 1, fTest.called)
+	})
+
+	t.Run("OnError Skip on a failing step does not cascade to dependents", func(t *testing.T) {
+		fBuild, fTest := new(mock), new(mock)
+		g := NewGraph(Steps{
+			{Name: "build", Run: fBuild.throw, OnError: Skip},
+			{Name: "test", Run: fTest.success, DependsOn: []string{"build"}},
+		})
+
+		results, err := g.Run(context.Background())
+
+		assert.NoError(t, err)
+		assert.True(t, results[0].Failed)
+		assert.False(t, results[1].Skipped)
+		assert.Equal(t, 1, fTest.called)
+	})
+
+	t.Run("unknown dependency is reported as an error", func(t *testing.T) {
+		g := NewGraph(Steps{{Name: "deploy", DependsOn: []string{"missing"}}})
+
+		_, err := g.Run(context.Background())
+
+		assert.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "missing"), "wrong error type")
+	})
+
+	t.Run("a cycle is detected and reported by name", func(t *testing.T) {
+		g := NewGraph(Steps{
+			{Name: "a", DependsOn: []string{"b"}},
+			{Name: "b", DependsOn: []string{"a"}},
+		})
+
+		_, err := g.Run(context.Background())
+
+		assert.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "cycle"), "wrong error type")
+		assert.True(t, strings.Contains(err.Error(), "a"), "expected cycle member in error")
+		assert.True(t, strings.Contains(err.Error(), "b"), "expected cycle member in error")
+	})
+}
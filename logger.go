@@ -0,0 +1,132 @@
+package runner
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Logger receives notifications about a run's progress. Implementations
+// let callers route runner's output through their own logging stack
+// instead of the built-in zerolog logger that Run and RunContext use.
+type Logger interface {
+	StepStart(index int, name string)
+	StepSkip(index int, name string)
+	StepAttempt(index int, name string, attempt int, err error, duration time.Duration)
+	StepEnd(index int, name string, err error, duration time.Duration)
+	StepRollback(index int, name string, err error)
+	RunEnd(summary string)
+}
+
+// NopLogger is a Logger that discards every event.
+type NopLogger struct{}
+
+// StepStart does nothing.
+func (NopLogger) StepStart(index int, name string) {}
+
+// StepSkip does nothing.
+func (NopLogger) StepSkip(index int, name string) {}
+
+// StepAttempt does nothing.
+func (NopLogger) StepAttempt(index int, name string, attempt int, err error, duration time.Duration) {
+}
+
+// StepEnd does nothing.
+func (NopLogger) StepEnd(index int, name string, err error, duration time.Duration) {}
+
+// StepRollback does nothing.
+func (NopLogger) StepRollback(index int, name string, err error) {}
+
+// RunEnd does nothing.
+func (NopLogger) RunEnd(summary string) {}
+
+// ZerologLogger adapts a zerolog.Logger to Logger, producing the same log
+// lines Run and RunContext have always produced.
+type ZerologLogger struct {
+	Logger zerolog.Logger
+}
+
+// StepStart logs that a step is about to run.
+func (l ZerologLogger) StepStart(index int, name string) {
+	l.Logger.Info().Int("step", index).Str("name", name).Msg("do step")
+}
+
+// StepSkip logs that a step was skipped.
+func (l ZerologLogger) StepSkip(index int, name string) {
+	l.Logger.Info().Int("step", index).Str("name", name).Msg("skip step")
+}
+
+// StepAttempt logs the outcome of a single retry attempt, as an error
+// level event if err is non-nil.
+func (l ZerologLogger) StepAttempt(index int, name string, attempt int, err error, duration time.Duration) {
+	if err != nil {
+		l.Logger.Error().Err(err).Int("step", index).Str("name", name).Int("attempt", attempt).Dur("elapsed", duration).Msg("step attempt failed")
+		return
+	}
+	l.Logger.Info().Int("step", index).Str("name", name).Int("attempt", attempt).Dur("elapsed", duration).Msg("step attempt succeeded")
+}
+
+// StepEnd logs the outcome of a step, as an error level event if err is
+// non-nil.
+func (l ZerologLogger) StepEnd(index int, name string, err error, duration time.Duration) {
+	event := l.Logger.Info()
+	if err != nil {
+		event = l.Logger.Error().Err(err)
+	}
+	event.Int("step", index).Str("name", name).Dur("duration", duration).Msg("step end")
+}
+
+// StepRollback logs that a step's Rollback function returned an error.
+func (l ZerologLogger) StepRollback(index int, name string, err error) {
+	l.Logger.Error().Err(err).Int("step", index).Str("name", name).Msg("rollback failed")
+}
+
+// RunEnd logs the summary of a completed run.
+func (l ZerologLogger) RunEnd(summary string) {
+	l.Logger.Info().Msg(summary)
+}
+
+// SlogLogger adapts a *slog.Logger to Logger.
+type SlogLogger struct {
+	Logger *slog.Logger
+}
+
+// StepStart logs that a step is about to run.
+func (l SlogLogger) StepStart(index int, name string) {
+	l.Logger.Info("do step", "step", index, "name", name)
+}
+
+// StepSkip logs that a step was skipped.
+func (l SlogLogger) StepSkip(index int, name string) {
+	l.Logger.Info("skip step", "step", index, "name", name)
+}
+
+// StepAttempt logs the outcome of a single retry attempt, at error level
+// if err is non-nil.
+func (l SlogLogger) StepAttempt(index int, name string, attempt int, err error, duration time.Duration) {
+	if err != nil {
+		l.Logger.Error("step attempt failed", "step", index, "name", name, "attempt", attempt, "duration", duration, "error", err)
+		return
+	}
+	l.Logger.Info("step attempt succeeded", "step", index, "name", name, "attempt", attempt, "duration", duration)
+}
+
+// StepEnd logs the outcome of a step, at error level if err is non-nil.
+func (l SlogLogger) StepEnd(index int, name string, err error, duration time.Duration) {
+	if err != nil {
+		l.Logger.Error("step end", "step", index, "name", name, "duration", duration, "error", err)
+		return
+	}
+	l.Logger.Info("step end", "step", index, "name", name, "duration", duration)
+}
+
+// StepRollback logs that a step's Rollback function returned an error.
+func (l SlogLogger) StepRollback(index int, name string, err error) {
+	l.Logger.Error("rollback failed", "step", index, "name", name, "error", err)
+}
+
+// RunEnd logs the summary of a completed run.
+func (l SlogLogger) RunEnd(summary string) {
+	l.Logger.Info(summary)
+}
@@ -0,0 +1,61 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZerologLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := ZerologLogger{Logger: zerolog.New(&buf)}
+
+	l.StepStart(0, "a")
+	l.StepSkip(1, "b")
+	l.StepAttempt(0, "a", 1, errors.New("boom"), time.Millisecond)
+	l.StepEnd(0, "a", nil, time.Millisecond)
+	l.StepEnd(0, "a", errors.New("boom"), time.Millisecond)
+	l.StepRollback(0, "a", errors.New("rollback boom"))
+	l.RunEnd("done")
+
+	out := buf.String()
+	assert.Contains(t, out, "do step")
+	assert.Contains(t, out, "skip step")
+	assert.Contains(t, out, "boom")
+	assert.Contains(t, out, "rollback boom")
+	assert.Contains(t, out, "done")
+}
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := SlogLogger{Logger: slog.New(slog.NewTextHandler(&buf, nil))}
+
+	l.StepStart(0, "a")
+	l.StepSkip(1, "b")
+	l.StepAttempt(0, "a", 1, errors.New("boom"), time.Millisecond)
+	l.StepEnd(0, "a", errors.New("boom"), time.Millisecond)
+	l.StepRollback(0, "a", errors.New("rollback boom"))
+	l.RunEnd("done")
+
+	out := buf.String()
+	assert.Contains(t, out, "do step")
+	assert.Contains(t, out, "skip step")
+	assert.Contains(t, out, "boom")
+	assert.Contains(t, out, "rollback boom")
+	assert.Contains(t, out, "done")
+}
+
+func TestNopLogger(t *testing.T) {
+	var l NopLogger
+	l.StepStart(0, "a")
+	l.StepSkip(0, "a")
+	l.StepAttempt(0, "a", 1, nil, 0)
+	l.StepEnd(0, "a", nil, 0)
+	l.StepRollback(0, "a", errors.New("boom"))
+	l.RunEnd("done")
+}
@@ -0,0 +1,112 @@
+package runner
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Result is the outcome of running a single step, as produced by RunParallel.
+type Result struct {
+	Index    int           // Index of the step in the original Steps slice
+	Name     string        // Name of the step, as returned by getName
+	Duration time.Duration // How long the step took to run
+	Err      error         // The error returned by the step, if any
+	Skipped  bool          // True if the step was skipped
+	Failed   bool          // True if the step ran and returned an error
+}
+
+// RunParallel runs the steps in ss, executing consecutive runs of
+// non-Dependent steps concurrently, with at most maxConcurrency steps
+// in flight at once. A maxConcurrency below 1 is treated as 1. A
+// Dependent step acts as a barrier: every step before it is given the
+// chance to finish before it starts, and no later step starts until it
+// has finished. Cancelling ctx stops any step from starting that hasn't
+// already, and is also passed to each running step's RunCtx and Timeout
+// the same way RunContext does; Retry is honored too, the same way Run
+// honors it.
+//
+// One Result is returned per step, in step order, regardless of
+// whether the step ran, was skipped, or failed.
+func (ss Steps) RunParallel(ctx context.Context, maxConcurrency int) []Result {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	results := make([]Result, len(ss))
+	sem := make(chan struct{}, maxConcurrency)
+
+	runBatch := func(indexes []int) {
+		var wg sync.WaitGroup
+		for _, i := range indexes {
+			i := i
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				results[i] = runStep(ctx, ss[i], i)
+			}()
+		}
+		wg.Wait()
+	}
+
+	var batch []int
+	for i, step := range ss {
+		if step.Dependent {
+			runBatch(batch)
+			batch = nil
+			results[i] = runStep(ctx, step, i)
+			continue
+		}
+		batch = append(batch, i)
+	}
+	runBatch(batch)
+
+	return results
+}
+
+// runStep runs step the same way runStepContext and runWithRetry do
+// together: RunCtx/Timeout are honored if the step defines them, and a
+// failing attempt is retried according to Retry, so a Step behaves the
+// same regardless of whether it's run through Steps.Run, RunParallel, or
+// Graph.Run.
+func runStep(ctx context.Context, step Step, index int) Result {
+	res := Result{Index: index, Name: getName(step, index)}
+
+	if step.shouldSkip() {
+		res.Skipped = true
+		return res
+	}
+
+	if err := ctx.Err(); err != nil {
+		res.Skipped = true
+		return res
+	}
+
+	stepCtx := ctx
+	cancel := func() {}
+	if step.Timeout > 0 {
+		stepCtx, cancel = context.WithTimeout(ctx, step.Timeout)
+	}
+	defer cancel()
+
+	maxAttempts := step.Retry.maxAttempts()
+	backoff := step.Retry.InitialBackoff
+
+	start := time.Now()
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err = runOnce(stepCtx, step)
+		if err == nil || attempt == maxAttempts || !step.Retry.shouldRetry(err) {
+			break
+		}
+		time.Sleep(step.Retry.backoffWithJitter(backoff))
+		backoff = step.Retry.nextBackoff(backoff)
+	}
+	res.Duration = time.Since(start)
+	res.Err = err
+	res.Failed = err != nil
+
+	return res
+}
@@ -0,0 +1,121 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSteps_RunParallel(t *testing.T) {
+	t.Run("runs independent steps and collects results", func(t *testing.T) {
+		f1, f2, f3 := new(mock), new(mock), new(mock)
+		ss := Steps([]Step{{Name: "a", Run: f1.success}, {Name: "b", Run: f2.success}, {Name: "c", Run: f3.success}})
+
+		results := ss.RunParallel(context.Background(), 2)
+
+		assert.Len(t, results, 3)
+		for _, r := range results {
+			assert.False(t, r.Skipped)
+			assert.False(t, r.Failed)
+			assert.NoError(t, r.Err)
+		}
+		assert.Equal(t, 1, f1.called)
+		assert.Equal(t, 1, f2.called)
+		assert.Equal(t, 1, f3.called)
+	})
+
+	t.Run("failed step is reported but does not stop others", func(t *testing.T) {
+		f1, f2 := new(mock), new(mock)
+		ss := Steps([]Step{{Name: "a", Run: f1.throw}, {Name: "b", Run: f2.success}})
+
+		results := ss.RunParallel(context.Background(), 2)
+
+		assert.True(t, results[0].Failed)
+		assert.Error(t, results[0].Err)
+		assert.False(t, results[1].Failed)
+	})
+
+	t.Run("skipped step is reported and not run", func(t *testing.T) {
+		f1 := new(mock)
+		ss := Steps([]Step{{Name: "a", Run: f1.success, SkipFunc: SkipAlways}})
+
+		results := ss.RunParallel(context.Background(), 1)
+
+		assert.True(t, results[0].Skipped)
+		assert.Equal(t, 0, f1.called)
+	})
+
+	t.Run("dependent step waits for previous batch", func(t *testing.T) {
+		f1, f2, f3 := new(mock), new(mock), new(mock)
+		ss := Steps([]Step{
+			{Name: "a", Run: f1.success},
+			{Name: "b", Run: f2.success, Dependent: true},
+			{Name: "c", Run: f3.success},
+		})
+
+		results := ss.RunParallel(context.Background(), 2)
+
+		assert.Len(t, results, 3)
+		assert.Equal(t, 1, f1.called)
+		assert.Equal(t, 1, f2.called)
+		assert.Equal(t, 1, f3.called)
+	})
+
+	t.Run("maxConcurrency of zero is treated as 1, not a deadlock", func(t *testing.T) {
+		f1, f2 := new(mock), new(mock)
+		ss := Steps([]Step{{Name: "a", Run: f1.success}, {Name: "b", Run: f2.success}})
+
+		results := ss.RunParallel(context.Background(), 0)
+
+		assert.Len(t, results, 2)
+		assert.Equal(t, 1, f1.called)
+		assert.Equal(t, 1, f2.called)
+	})
+
+	t.Run("negative maxConcurrency is treated as 1, not a panic", func(t *testing.T) {
+		f1 := new(mock)
+		ss := Steps([]Step{{Name: "a", Run: f1.success}})
+
+		results := ss.RunParallel(context.Background(), -1)
+
+		assert.Len(t, results, 1)
+		assert.Equal(t, 1, f1.called)
+	})
+
+	t.Run("Retry is honored, the same way it is by Run", func(t *testing.T) {
+		f1 := &flaky{failures: 2}
+		ss := Steps([]Step{{Name: "a", Run: f1.run, Retry: Retry{MaxAttempts: 3}}})
+
+		results := ss.RunParallel(context.Background(), 1)
+
+		assert.False(t, results[0].Failed)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, 3, f1.called)
+	})
+
+	t.Run("Timeout is enforced, the same way it is by RunContext", func(t *testing.T) {
+		ss := Steps([]Step{{Name: "a", Timeout: time.Millisecond, RunCtx: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}}})
+
+		results := ss.RunParallel(context.Background(), 1)
+
+		assert.True(t, results[0].Failed)
+		assert.Error(t, results[0].Err)
+	})
+
+	t.Run("cancelled context skips remaining steps", func(t *testing.T) {
+		f1 := new(mock)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ss := Steps([]Step{{Name: "a", Run: f1.success}})
+
+		results := ss.RunParallel(ctx, 1)
+
+		assert.True(t, results[0].Skipped)
+		assert.Equal(t, 0, f1.called)
+	})
+}
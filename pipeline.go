@@ -0,0 +1,113 @@
+package runner
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Pipeline wraps Steps with lifecycle hooks around the run itself:
+// BeforeAll and AfterAll bracket the whole pipeline, and Before/After
+// bracket each individual step. It lets install/uninstall or
+// provision/teardown style pipelines clean up safely after a partial
+// failure, which the flat, all-or-nothing Steps.Run cannot express.
+type Pipeline struct {
+	Steps     Steps
+	Logger    Logger                                      // Optional; receives retry-attempt and rollback events. A nil Logger is treated as NopLogger.
+	BeforeAll func() error                                // Runs once before the first step
+	AfterAll  func() error                                // Always runs once the pipeline is done, success or not
+	Before    func(step Step, index int) error            // Runs before each step
+	After     func(step Step, index int, err error) error // Runs after each step, with its error, if any
+}
+
+// NewPipeline builds a Pipeline from ss.
+func NewPipeline(ss Steps) Pipeline {
+	return Pipeline{Steps: ss}
+}
+
+// Run executes the pipeline from a specified zero based starting index.
+// Each step is run through its own retry and OnError policy, the same way
+// Steps.Run honors them. If a step ultimately fails, Rollback is invoked,
+// in reverse order, for every step that had already completed
+// successfully, and the pipeline stops without running later steps.
+// AfterAll always runs, even after an error, the way a defer would.
+func (p Pipeline) Run(startIndex int) (err error) {
+	logger := p.Logger
+	if logger == nil {
+		logger = NopLogger{}
+	}
+
+	if p.AfterAll != nil {
+		defer func() {
+			if afterErr := p.AfterAll(); afterErr != nil && err == nil {
+				err = errors.Wrap(afterErr, "AfterAll hook failed")
+			}
+		}()
+	}
+
+	if startStep := p.Steps[startIndex]; startStep.Dependent {
+		return errors.Errorf("step %v: %q cannot be started independently, it relies on previous steps", startIndex, startStep.Name)
+	}
+
+	if p.BeforeAll != nil {
+		if err = p.BeforeAll(); err != nil {
+			return errors.Wrap(err, "BeforeAll hook failed")
+		}
+	}
+
+	var completed []int
+
+	for i := startIndex; i < len(p.Steps); i++ {
+		step := p.Steps[i]
+
+		if step.shouldSkip() {
+			continue
+		}
+
+		if p.Before != nil {
+			if hookErr := p.Before(step, i); hookErr != nil {
+				err = errors.Wrapf(hookErr, "before hook failed for step %v, %v", i, step.Name)
+				p.rollback(completed, logger)
+				return err
+			}
+		}
+
+		_, stepErr := step.runWithRetry(logger, i)
+
+		if p.After != nil {
+			if hookErr := p.After(step, i, stepErr); hookErr != nil && stepErr == nil {
+				stepErr = hookErr
+			}
+		}
+
+		if stepErr != nil {
+			switch step.OnError {
+			case Continue, Skip:
+				continue
+			default:
+				err = errors.Wrapf(stepErr, "could not perform step %v, %v", i, step.Name)
+				p.rollback(completed, logger)
+				return err
+			}
+		}
+
+		completed = append(completed, i)
+	}
+
+	return nil
+}
+
+// rollback invokes Rollback, in reverse order, for every completed step
+// that defines one. Rollback failures are reported to logger rather than
+// returned, so that every completed step still gets the chance to roll
+// back.
+func (p Pipeline) rollback(completed []int, logger Logger) {
+	for i := len(completed) - 1; i >= 0; i-- {
+		index := completed[i]
+		step := p.Steps[index]
+		if step.Rollback == nil {
+			continue
+		}
+		if err := step.Rollback(); err != nil {
+			logger.StepRollback(index, step.Name, err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipeline_Run(t *testing.T) {
+	t.Run("runs BeforeAll, each step, and AfterAll in order", func(t *testing.T) {
+		var order []string
+		f1, f2 := new(mock), new(mock)
+		p := Pipeline{
+			Steps: Steps{{Name: "a", Run: f1.success}, {Name: "b", Run: f2.success}},
+			BeforeAll: func() error {
+				order = append(order, "before-all")
+				return nil
+			},
+			AfterAll: func() error {
+				order = append(order, "after-all")
+				return nil
+			},
+			Before: func(step Step, index int) error {
+				order = append(order, "before:"+step.Name)
+				return nil
+			},
+			After: func(step Step, index int, err error) error {
+				order = append(order, "after:"+step.Name)
+				return nil
+			},
+		}
+
+		err := p.Run(0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"before-all", "before:a", "after:a", "before:b", "after:b", "after-all"}, order)
+	})
+
+	t.Run("AfterAll always runs, even when a step fails", func(t *testing.T) {
+		afterAllCalled := false
+		f1 := new(mock)
+		p := Pipeline{
+			Steps:    Steps{{Name: "a", Run: f1.throw}},
+			AfterAll: func() error { afterAllCalled = true; return nil },
+		}
+
+		err := p.Run(0)
+
+		assert.Error(t, err)
+		assert.True(t, afterAllCalled)
+	})
+
+	t.Run("rolls back completed steps in reverse order on a later failure", func(t *testing.T) {
+		var rolledBack []string
+		f1, f2, f3 := new(mock), new(mock), new(mock)
+		p := NewPipeline(Steps{
+			{Name: "a", Run: f1.success, Rollback: func() error { rolledBack = append(rolledBack, "a"); return nil }},
+			{Name: "b", Run: f2.success, Rollback: func() error { rolledBack = append(rolledBack, "b"); return nil }},
+			{Name: "c", Run: f3.throw},
+		})
+
+		err := p.Run(0)
+
+		assert.Error(t, err)
+		assert.Equal(t, []string{"b", "a"}, rolledBack)
+	})
+
+	t.Run("OnError Continue skips rollback and keeps running", func(t *testing.T) {
+		f1, f2 := new(mock), new(mock)
+		p := NewPipeline(Steps{
+			{Name: "a", Run: f1.throw, OnError: Continue},
+			{Name: "b", Run: f2.success},
+		})
+
+		err := p.Run(0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, f2.called)
+	})
+
+	t.Run("starting on a Dependent step -> error", func(t *testing.T) {
+		f1 := new(mock)
+		p := NewPipeline(Steps{{Name: "a", Run: f1.success, Dependent: true}})
+
+		err := p.Run(0)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be started independently")
+		assert.Equal(t, 0, f1.called)
+	})
+
+	t.Run("attempt and rollback events go to Logger, never to the global zerolog logger", func(t *testing.T) {
+		previous := log.Logger
+		var zerologOutput bytes.Buffer
+		log.Logger = zerolog.New(&zerologOutput)
+		defer func() { log.Logger = previous }()
+
+		f1, f2 := new(mock), new(mock)
+		logger := &recordingLogger{}
+		p := Pipeline{
+			Steps: Steps{
+				{Name: "a", Run: f1.success, Rollback: func() error { return errors.New("rollback boom") }},
+				{Name: "b", Run: f2.throw},
+			},
+			Logger: logger,
+		}
+
+		err := p.Run(0)
+
+		assert.Error(t, err)
+		assert.Equal(t, []int{1, 1}, logger.attempts)
+		assert.Equal(t, []string{"a"}, logger.rollbacks)
+		assert.Empty(t, zerologOutput.String(), "Pipeline.Run must not write to the global zerolog logger")
+	})
+}
@@ -0,0 +1,115 @@
+package runner
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// StepStatus describes the terminal state of a step in a Report.
+type StepStatus string
+
+const (
+	// StatusOK means the step ran and succeeded.
+	StatusOK StepStatus = "ok"
+	// StatusFailed means the step ran and its last attempt returned an error.
+	StatusFailed StepStatus = "failed"
+	// StatusSkipped means the step was never run.
+	StatusSkipped StepStatus = "skipped"
+)
+
+// StepReport is the recorded outcome of a single step, as produced by
+// RunWithReport.
+type StepReport struct {
+	Index      int           `json:"index"`
+	Name       string        `json:"name"`
+	Status     StepStatus    `json:"status"`
+	Duration   time.Duration `json:"duration"`
+	Attempts   int           `json:"attempts"`
+	Error      string        `json:"error,omitempty"`
+	ErrorChain []string      `json:"errorChain,omitempty"`
+}
+
+// Report is a machine-readable summary of a run, suitable for
+// json.Marshal.
+type Report struct {
+	Steps []StepReport `json:"steps"`
+}
+
+// JSON marshals the report to indented JSON.
+func (r Report) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+func errorChain(err error) []string {
+	var chain []string
+	for err != nil {
+		chain = append(chain, err.Error())
+		err = stderrors.Unwrap(err)
+	}
+	return chain
+}
+
+// RunWithReport runs from a specified zero based starting index, the same
+// way Run does, but routes every notification, including per-attempt
+// retry events, through logger instead of the built-in zerolog logger,
+// and returns a Report describing the status, duration, attempt count,
+// and error chain of every step. A nil logger is treated as NopLogger.
+func (ss Steps) RunWithReport(startIndex int, logger Logger) (Report, error) {
+	if logger == nil {
+		logger = NopLogger{}
+	}
+
+	report := Report{Steps: make([]StepReport, len(ss))}
+
+	if startStep := ss[startIndex]; startStep.Dependent {
+		return report, errors.Errorf("step %v: %q cannot be started independently, it relies on previous steps", startIndex, startStep.Name)
+	}
+
+	var runErr error
+	for i, step := range ss {
+		name := getName(step, i)
+
+		if i < startIndex || step.shouldSkip() {
+			logger.StepSkip(i, step.Name)
+			report.Steps[i] = StepReport{Index: i, Name: name, Status: StatusSkipped}
+			continue
+		}
+
+		logger.StepStart(i, step.Name)
+
+		start := time.Now()
+		attempts, err := step.runWithRetry(logger, i)
+		duration := time.Since(start)
+
+		logger.StepEnd(i, step.Name, err, duration)
+
+		sr := StepReport{Index: i, Name: name, Duration: duration, Attempts: attempts}
+		if err != nil {
+			sr.Status = StatusFailed
+			sr.Error = err.Error()
+			sr.ErrorChain = errorChain(err)
+		} else {
+			sr.Status = StatusOK
+		}
+		report.Steps[i] = sr
+
+		if err != nil {
+			switch step.OnError {
+			case Continue, Skip:
+			default:
+				runErr = errors.Wrapf(err, "could not perform step %v, %v", i, step.Name)
+				for j := i + 1; j < len(ss); j++ {
+					report.Steps[j] = StepReport{Index: j, Name: getName(ss[j], j), Status: StatusSkipped}
+				}
+				logger.RunEnd(runErr.Error())
+				return report, runErr
+			}
+		}
+	}
+
+	logger.RunEnd("run complete")
+	return report, nil
+}
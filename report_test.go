@@ -0,0 +1,110 @@
+package runner
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingLogger struct {
+	starts    []string
+	skips     []string
+	attempts  []int
+	ends      []string
+	rollbacks []string
+	runEnded  string
+}
+
+func (l *recordingLogger) StepStart(index int, name string) { l.starts = append(l.starts, name) }
+func (l *recordingLogger) StepSkip(index int, name string)  { l.skips = append(l.skips, name) }
+func (l *recordingLogger) StepAttempt(index int, name string, attempt int, err error, duration time.Duration) {
+	l.attempts = append(l.attempts, attempt)
+}
+func (l *recordingLogger) StepEnd(index int, name string, err error, duration time.Duration) {
+	l.ends = append(l.ends, name)
+}
+func (l *recordingLogger) StepRollback(index int, name string, err error) {
+	l.rollbacks = append(l.rollbacks, name)
+}
+func (l *recordingLogger) RunEnd(summary string) { l.runEnded = summary }
+
+func TestSteps_RunWithReport(t *testing.T) {
+	t.Run("reports status, duration and attempts for every step", func(t *testing.T) {
+		f1, f2 := new(mock), new(mock)
+		ss := Steps([]Step{{Name: "a", Run: f1.success}, {Name: "b", Run: f2.success, SkipFunc: SkipAlways}})
+		logger := &recordingLogger{}
+
+		report, err := ss.RunWithReport(0, logger)
+
+		assert.NoError(t, err)
+		assert.Equal(t, StatusOK, report.Steps[0].Status)
+		assert.Equal(t, 1, report.Steps[0].Attempts)
+		assert.Equal(t, StatusSkipped, report.Steps[1].Status)
+		assert.Equal(t, []string{"a"}, logger.starts)
+		assert.Equal(t, []string{"b"}, logger.skips)
+		assert.Equal(t, []int{1}, logger.attempts)
+		assert.NotEmpty(t, logger.runEnded)
+	})
+
+	t.Run("attempt-level events go to the supplied Logger, never to the global zerolog logger", func(t *testing.T) {
+		previous := log.Logger
+		var zerologOutput bytes.Buffer
+		log.Logger = zerolog.New(&zerologOutput)
+		defer func() { log.Logger = previous }()
+
+		f1 := &flaky{failures: 2}
+		ss := Steps([]Step{{Name: "a", Run: f1.run, Retry: Retry{MaxAttempts: 3}}})
+		logger := &recordingLogger{}
+
+		_, err := ss.RunWithReport(0, logger)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, logger.attempts)
+		assert.Empty(t, zerologOutput.String(), "RunWithReport must not write to the global zerolog logger")
+	})
+
+	t.Run("a nil Logger suppresses attempt-level output entirely", func(t *testing.T) {
+		previous := log.Logger
+		var zerologOutput bytes.Buffer
+		log.Logger = zerolog.New(&zerologOutput)
+		defer func() { log.Logger = previous }()
+
+		f1 := new(mock)
+		ss := Steps([]Step{{Name: "a", Run: f1.throw}})
+
+		_, err := ss.RunWithReport(0, nil)
+
+		assert.Error(t, err)
+		assert.Empty(t, zerologOutput.String(), "a nil Logger must not fall back to the global zerolog logger")
+	})
+
+	t.Run("a failing step is reported with its error chain, and downstream steps as skipped", func(t *testing.T) {
+		f1, f2 := new(mock), new(mock)
+		ss := Steps([]Step{{Name: "a", Run: f1.throw}, {Name: "b", Run: f2.success}})
+
+		report, err := ss.RunWithReport(0, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, StatusFailed, report.Steps[0].Status)
+		assert.NotEmpty(t, report.Steps[0].Error)
+		assert.NotEmpty(t, report.Steps[0].ErrorChain)
+		assert.Equal(t, StatusSkipped, report.Steps[1].Status)
+		assert.Equal(t, 0, f2.called)
+	})
+
+	t.Run("marshals to JSON", func(t *testing.T) {
+		f1 := new(mock)
+		ss := Steps([]Step{{Name: "a", Run: f1.success}})
+
+		report, err := ss.RunWithReport(0, nil)
+		assert.NoError(t, err)
+
+		data, err := report.JSON()
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), `"status": "ok"`)
+	})
+}
@@ -0,0 +1,102 @@
+package runner
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Retry describes how a step should be retried after a failing attempt.
+// The zero value means no retry: the step is attempted once.
+type Retry struct {
+	MaxAttempts    int              // Total attempts, including the first. Zero or one means no retry.
+	InitialBackoff time.Duration    // Delay before the first retry
+	Multiplier     float64          // Growth factor applied to the backoff after each attempt
+	MaxBackoff     time.Duration    // Upper bound for the backoff delay, ignored if zero
+	Jitter         float64          // Fraction of the backoff to randomize, e.g. 0.1 for +/-10%
+	RetryIf        func(error) bool // Optional classifier; a nil RetryIf retries on any error
+}
+
+func (r Retry) maxAttempts() int {
+	if r.MaxAttempts < 1 {
+		return 1
+	}
+	return r.MaxAttempts
+}
+
+func (r Retry) shouldRetry(err error) bool {
+	if r.RetryIf == nil {
+		return true
+	}
+	return r.RetryIf(err)
+}
+
+func (r Retry) nextBackoff(current time.Duration) time.Duration {
+	if r.Multiplier <= 0 {
+		return current
+	}
+	next := time.Duration(float64(current) * r.Multiplier)
+	if r.MaxBackoff > 0 && next > r.MaxBackoff {
+		return r.MaxBackoff
+	}
+	return next
+}
+
+func (r Retry) backoffWithJitter(backoff time.Duration) time.Duration {
+	if r.Jitter <= 0 || backoff <= 0 {
+		return backoff
+	}
+	spread := float64(backoff) * r.Jitter
+	return backoff + time.Duration(spread*(rand.Float64()*2-1))
+}
+
+// OnError describes what Steps.Run should do when a step fails after its
+// retry policy, if any, is exhausted.
+type OnError int
+
+const (
+	// Abort stops the pipeline and returns the step's error. This is the
+	// zero value, so existing steps keep their current behavior.
+	Abort OnError = iota
+	// Continue logs the error and moves on to the next step.
+	Continue
+	// Skip treats the failing step like one that was skipped, and moves
+	// on to the next step.
+	Skip
+)
+
+// runWithRetry runs the step's Run function, retrying it according to the
+// step's Retry policy, and reports each attempt to logger via StepAttempt,
+// identifying the step by index. It returns the number of attempts made
+// and the last error seen once attempts are exhausted, or nil once an
+// attempt succeeds.
+func (s Step) runWithRetry(logger Logger, index int) (int, error) {
+	if s.Run == nil {
+		return 0, nil
+	}
+
+	maxAttempts := s.Retry.maxAttempts()
+	backoff := s.Retry.InitialBackoff
+
+	var err error
+	attempt := 1
+	for ; attempt <= maxAttempts; attempt++ {
+		start := time.Now()
+		err = s.Run()
+		elapsed := time.Since(start)
+
+		logger.StepAttempt(index, s.Name, attempt, err, elapsed)
+
+		if err == nil {
+			return attempt, nil
+		}
+
+		if attempt == maxAttempts || !s.Retry.shouldRetry(err) {
+			break
+		}
+
+		time.Sleep(s.Retry.backoffWithJitter(backoff))
+		backoff = s.Retry.nextBackoff(backoff)
+	}
+
+	return attempt, err
+}
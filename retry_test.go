@@ -0,0 +1,105 @@
+package runner
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type flaky struct {
+	failures int
+	called   int
+}
+
+func (f *flaky) run() error {
+	f.called++
+	if f.called <= f.failures {
+		return errors.New("not yet")
+	}
+	return nil
+}
+
+func TestStep_runWithRetry(t *testing.T) {
+	t.Run("succeeds without retrying when Retry is the zero value", func(t *testing.T) {
+		f := new(flaky)
+		s := Step{Run: f.run}
+
+		_, err := s.runWithRetry(NopLogger{}, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, f.called)
+	})
+
+	t.Run("retries up to MaxAttempts and then succeeds", func(t *testing.T) {
+		f := &flaky{failures: 2}
+		s := Step{Run: f.run, Retry: Retry{MaxAttempts: 3}}
+
+		attempts, err := s.runWithRetry(NopLogger{}, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, 3, f.called)
+	})
+
+	t.Run("returns the last error once attempts are exhausted", func(t *testing.T) {
+		f := &flaky{failures: 5}
+		s := Step{Run: f.run, Retry: Retry{MaxAttempts: 2}}
+
+		_, err := s.runWithRetry(NopLogger{}, 0)
+
+		assert.Error(t, err)
+		assert.Equal(t, 2, f.called)
+	})
+
+	t.Run("RetryIf stops retrying for non-matching errors", func(t *testing.T) {
+		f := &flaky{failures: 5}
+		s := Step{Run: f.run, Retry: Retry{MaxAttempts: 3, RetryIf: func(err error) bool { return false }}}
+
+		_, err := s.runWithRetry(NopLogger{}, 0)
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, f.called)
+	})
+
+	t.Run("backoff grows with the multiplier and respects MaxBackoff", func(t *testing.T) {
+		r := Retry{InitialBackoff: 10 * time.Millisecond, Multiplier: 2, MaxBackoff: 15 * time.Millisecond}
+
+		next := r.nextBackoff(r.InitialBackoff)
+		assert.Equal(t, 15*time.Millisecond, next)
+	})
+}
+
+func TestSteps_Run_OnError(t *testing.T) {
+	t.Run("Continue logs the error and runs subsequent steps", func(t *testing.T) {
+		f1, f2 := new(mock), new(mock)
+		ss := Steps([]Step{{Run: f1.throw, OnError: Continue}, {Run: f2.success}})
+
+		err := ss.Run(0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, f1.called)
+		assert.Equal(t, 1, f2.called)
+	})
+
+	t.Run("Skip treats a failing step like a skipped one and continues", func(t *testing.T) {
+		f1, f2 := new(mock), new(mock)
+		ss := Steps([]Step{{Run: f1.throw, OnError: Skip}, {Run: f2.success}})
+
+		err := ss.Run(0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, f2.called)
+	})
+
+	t.Run("Abort is the default and stops the pipeline", func(t *testing.T) {
+		f1, f2 := new(mock), new(mock)
+		ss := Steps([]Step{{Run: f1.throw}, {Run: f2.success}})
+
+		err := ss.Run(0)
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, f2.called)
+	})
+}
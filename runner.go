@@ -5,9 +5,11 @@
 package runner
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
@@ -19,10 +21,16 @@ func getName(s Step, index int) string {
 
 // Step is a machine step for processing
 type Step struct {
-	Name      string       // The name of this step
-	Run       func() error // The run function. A returned error will stop any subsequent runs
-	Dependent bool         // A dependent step cannot be started from
-	SkipFunc               // Optional function to tell whether this step should be skipped
+	Name      string                      // The name of this step
+	Run       func() error                // The run function. A returned error will stop any subsequent runs, unless OnError is Continue or Skip
+	RunCtx    func(context.Context) error // Optional context-aware run function, preferred over Run by RunContext, RunParallel, and Graph.Run
+	Timeout   time.Duration               // Optional per-step timeout, enforced by RunContext, RunParallel, and Graph.Run
+	Retry     Retry                       // Optional retry policy, honored by Run, RunParallel, and Graph.Run
+	OnError   OnError                     // What Run and Graph.Run should do if the step fails after retries are exhausted
+	Dependent bool                        // A dependent step cannot be started from
+	DependsOn []string                    // Names of steps that must complete before this one, honored by Graph.Run
+	Rollback  func() error                // Optional rollback, run by Pipeline.Run in reverse order on later failure
+	SkipFunc                              // Optional function to tell whether this step should be skipped
 }
 
 // SkipFunc is a function type to determine whether the step should be skipped
@@ -68,6 +76,7 @@ func (ss Steps) GetStep(command string) (int, error) {
 // Run from a specified zero based starting index
 func (ss Steps) Run(startIndex int) error {
 	localLogger := log.Logger.With().Int("lastStep", len(ss)-1).Logger()
+	stepLogger := ZerologLogger{Logger: localLogger}
 
 	if startStep := ss[startIndex]; startStep.Dependent {
 		return errors.Errorf("step %v: %q cannot be started independently, it relies on previous steps", startIndex, startStep.Name)
@@ -76,11 +85,21 @@ func (ss Steps) Run(startIndex int) error {
 		if i >= startIndex && !step.shouldSkip() {
 			localLogger.Info().Int("step", i).Str("name", step.Name).Msg("do step")
 
-			err := step.Run()
-			if err != nil {
+			_, err := step.runWithRetry(stepLogger, i)
+			if err == nil {
+				continue
+			}
+
+			switch step.OnError {
+			case Continue:
+				localLogger.Info().Int("step", i).Str("name", step.Name).Err(err).Msg("step failed, continuing")
+				continue
+			case Skip:
+				localLogger.Info().Int("step", i).Str("name", step.Name).Err(err).Msg("step failed, treating as skipped")
+				continue
+			default:
 				return errors.Wrapf(err, "could not perform step %v, %v", i, step.Name)
 			}
-			continue
 		}
 
 		localLogger.Info().Int("step", i).Str("name", step.Name).Msg("skip step")